@@ -16,12 +16,11 @@ package math
 
 import (
 	"errors"
+	"fmt"
 	"math"
-	"reflect"
 
 	_math "github.com/gohugoio/hugo/common/math"
 	"github.com/spf13/cast"
-	"gonum.org/v1/gonum/mat"
 )
 
 // New returns a new instance of the math-namespaced template functions.
@@ -143,99 +142,129 @@ func (ns *Namespace) Sub(a, b interface{}) (interface{}, error) {
 	return _math.DoArithmetic(a, b, '-')
 }
 
-// MatrixMultiply returns res of matrix multiplication of a value
-func (ns *Namespace) MatrixMultiply(v interface{}, cI interface{}, resI interface{}, m []interface{}) (float64, error) {
-	// cast interfaces to types
-	value, errv := cast.ToFloat64E(v)
-	cIndex, errci := cast.ToIntE(cI)
-	resIndex, errri := cast.ToIntE(resI)
+// Rational is the best rational approximation p/q of some float64, split
+// into a whole part and a proper fraction Num/Denom so that the value it
+// approximates is Whole + Num/Denom (with the sign carried by whichever of
+// the two is non-zero).
+type Rational struct {
+	Whole, Num, Denom int64
+}
 
-	if errv != nil {
-		return 0, errors.New("MatrixMultiply can't be used with non float value")
+// String renders r the way existing templates expect from NearFactorize,
+// e.g. "2 3/4" or, for a value with no fractional part, just "2".
+func (r Rational) String() string {
+	if r.Denom == 0 || r.Num == 0 {
+		return cast.ToString(r.Whole)
 	}
 
-	if errci != nil || errri != nil {
-		return 0, errors.New("MatrixMultiply can't be used with non integer value")
+	if r.Whole == 0 {
+		return fmt.Sprintf("%d/%d", r.Num, r.Denom)
 	}
 
-	// Flatten array of arrays into array
-	var d []interface{}
-	for _, i := range m {
-		c := reflect.ValueOf(i)
-		for j := 0; j < c.Len(); j++ {
-			d = append(d, c.Index(j).Interface())
-		}
+	num := r.Num
+	if num < 0 {
+		num = -num
 	}
 
-	// Create typed float64 array from array d
-	mdata := make([]float64, len(d))
-	var err error
-	for i, unk := range d {
-		switch j := unk.(type) {
-		case int:
-			mdata[i] = float64(j)
-		case float64:
-			mdata[i] = j
-		case float32:
-			mdata[i] = float64(j)
-		case int64:
-			mdata[i] = float64(j)
-		// ...other cases...
-		default:
-			err = errors.New("MatrixMultiply: Unknown value is of incompatible type")
-		}
-	}
+	return fmt.Sprintf("%d %d/%d", r.Whole, num, r.Denom)
+}
 
+// Rationalize returns the best rational approximation of x with a
+// denominator no greater than maxDenominator, computed via the standard
+// continued-fraction (Stern-Brocot) algorithm.
+func (ns *Namespace) Rationalize(x, maxDenominator interface{}) (Rational, error) {
+	xf, err := cast.ToFloat64E(x)
 	if err != nil {
-		return 0, err
+		return Rational{}, errors.New("Rationalize can't be used with a non-float value")
 	}
 
-	// Create (dense) matrix from float64 array based on original data
-	size := len(m)
-	am := mat.NewDense(size, size, mdata)
+	maxDenom, err := cast.ToInt64E(maxDenominator)
+	if err != nil || maxDenom < 1 {
+		return Rational{}, errors.New("Rationalize requires a maxDenominator of at least 1")
+	}
 
-	// Symmetric mulitplication of value with created matrix
-	var resm mat.Dense
-	resm.Scale(value, am)
+	if math.IsNaN(xf) || math.IsInf(xf, 0) {
+		return Rational{}, errors.New("Rationalize can't be used with a non-finite value")
+	}
 
-	// Get result at desired index
-	return resm.At(resIndex, cIndex), nil
-}
+	neg := xf < 0
+	xf = math.Abs(xf)
 
-// NearFactorize returns the nearest integer, rounding half away from zero.
-func (ns *Namespace) NearFactorize(x interface{}) (string, error) {
-	xn := cast.ToFloat64(x)
+	whole := math.Floor(xf)
+	frac := xf - whole
 
-	if xn <= 0 || xn >= 8 {
-		return cast.ToString(math.Round(xn)), nil
+	w := int64(whole)
+	if frac == 0 {
+		if neg {
+			w = -w
+		}
+		return Rational{Whole: w}, nil
 	}
 
-	wholeNum := ""
-	if xn >= 1 {
-		flNum := math.Floor(xn)
-		wholeNum = cast.ToString(flNum) + " "
-		xn = xn - flNum
+	// Walk the continued-fraction expansion of frac, tracking successive
+	// convergents h_n/k_n via h_n = a*h_{n-1} + h_{n-2} (and likewise k_n)
+	// until the denominator would exceed maxDenom, then fall back to the
+	// best semiconvergent with a denominator within budget.
+	h0, h1 := int64(0), int64(1)
+	k0, k1 := int64(1), int64(0)
+	num, denom := int64(0), int64(1)
+	z := frac
+
+	for i := 0; i < 64; i++ {
+		a := int64(math.Floor(z))
+		hn := a*h1 + h0
+		kn := a*k1 + k0
+
+		if kn > maxDenom {
+			if k1 > 0 {
+				if m := (maxDenom - k0) / k1; m > 0 {
+					sh, sk := m*h1+h0, m*k1+k0
+					if math.Abs(frac-float64(sh)/float64(sk)) <= math.Abs(frac-float64(h1)/float64(k1)) {
+						num, denom = sh, sk
+					}
+				}
+			}
+			break
+		}
+
+		num, denom = hn, kn
+		h0, h1 = h1, hn
+		k0, k1 = k1, kn
+
+		rem := z - float64(a)
+		if rem < 1e-12 {
+			break
+		}
+		z = 1 / rem
 	}
 
-	if xn == 0 {
-		return cast.ToString(x), nil
+	// The semiconvergent fallback can land exactly on a whole number
+	// (denom == 1); fold that into the whole part rather than printing
+	// a spurious "n/1".
+	if denom == 1 {
+		w += num
+		num = 0
 	}
 
-	diff := float64(1)
-	res := ""
-	for i := 1; i < 8; i++ {
-		for j := 1 + i; j < 9; j++ {
-			iFloat := cast.ToFloat64(i)
-			jFloat := cast.ToFloat64(j)
-			cdiff := math.Abs((iFloat / jFloat) - xn)
-			if cdiff < diff {
-				res = string(wholeNum + cast.ToString(i) + "/" + cast.ToString(j))
-			} else {
-				break
-			}
-			diff = cdiff
-		}
+	if neg {
+		w = -w
+		num = -num
+	}
+
+	return Rational{Whole: w, Num: num, Denom: denom}, nil
+}
+
+// NearFactorize returns the nearest fraction with a small denominator,
+// formatted as e.g. "2 3/4".
+//
+// Deprecated: use Rationalize instead, which implements a correct
+// continued-fraction approximation and exposes the result as a struct
+// rather than a pre-formatted string.
+func (ns *Namespace) NearFactorize(x interface{}) (string, error) {
+	r, err := ns.Rationalize(x, 8)
+	if err != nil {
+		return "", err
 	}
 
-	return res, nil
+	return r.String(), nil
 }
@@ -0,0 +1,72 @@
+// Copyright 2017 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRationalize(t *testing.T) {
+	ns := New()
+
+	for _, test := range []struct {
+		x              interface{}
+		maxDenominator interface{}
+		want           string
+	}{
+		{2.75, 16, "2 3/4"},
+		{-2.75, 16, "-2 3/4"},
+		{0.75, 16, "3/4"},
+		{-0.75, 16, "-3/4"},
+		{4, 16, "4"},
+		{0, 16, "0"},
+		{2.5, 1, "3"},
+	} {
+		got, err := ns.Rationalize(test.x, test.maxDenominator)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got.String())
+	}
+}
+
+func TestRationalizeDenominatorWithinBudget(t *testing.T) {
+	ns := New()
+
+	got, err := ns.Rationalize(3.14159265, 1000)
+	require.NoError(t, err)
+	require.LessOrEqual(t, got.Denom, int64(1000))
+	require.InDelta(t, 3.14159265, float64(got.Whole)+float64(got.Num)/float64(got.Denom), 1e-3)
+}
+
+func TestRationalizeErrors(t *testing.T) {
+	ns := New()
+
+	_, err := ns.Rationalize("not-a-number", 16)
+	require.Error(t, err)
+
+	_, err = ns.Rationalize(1.5, 0)
+	require.Error(t, err)
+
+	_, err = ns.Rationalize(1.5, "not-a-number")
+	require.Error(t, err)
+}
+
+func TestNearFactorize(t *testing.T) {
+	ns := New()
+
+	got, err := ns.NearFactorize(2.75)
+	require.NoError(t, err)
+	require.Equal(t, "2 3/4", got)
+}
@@ -0,0 +1,230 @@
+// Copyright 2017 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cast"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Matrix is a template-facing wrapper around a dense gonum matrix, built
+// from a slice of rows via NewMatrix.
+type Matrix struct {
+	m *mat.Dense
+}
+
+// NewMatrix builds a Matrix from m, a slice of rows of numeric-coercible
+// values. The number of rows and columns is inferred from m; every row
+// must have the same length or an error is returned.
+func (ns *Namespace) NewMatrix(m []interface{}) (Matrix, error) {
+	if len(m) == 0 {
+		return Matrix{}, errors.New("NewMatrix can't be used with an empty slice")
+	}
+
+	rows := len(m)
+	var cols int
+	var data []float64
+	var errs []string
+
+	for i, rowv := range m {
+		row, ok := rowv.([]interface{})
+		if !ok {
+			var err error
+			row, err = cast.ToSliceE(rowv)
+			if err != nil {
+				return Matrix{}, fmt.Errorf("NewMatrix can't be used with a non-slice row at index %d", i)
+			}
+		}
+
+		if i == 0 {
+			cols = len(row)
+			if cols == 0 {
+				return Matrix{}, errors.New("NewMatrix can't be used with empty rows")
+			}
+		} else if len(row) != cols {
+			return Matrix{}, fmt.Errorf("NewMatrix can't be used with ragged input: row %d has %d columns, want %d", i, len(row), cols)
+		}
+
+		for j, v := range row {
+			f, err := cast.ToFloat64E(v)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("row %d, col %d: %s", i, j, err))
+				continue
+			}
+			data = append(data, f)
+		}
+	}
+
+	if len(errs) > 0 {
+		return Matrix{}, fmt.Errorf("NewMatrix can't be used with non-numeric values: %s", errs)
+	}
+
+	return Matrix{m: mat.NewDense(rows, cols, data)}, nil
+}
+
+// Dims returns the number of rows and columns in m.
+func (m Matrix) Dims() (int, int) {
+	return m.m.Dims()
+}
+
+// At returns the value of m at row i, column j.
+func (m Matrix) At(i, j interface{}) (float64, error) {
+	ii, erri := cast.ToIntE(i)
+	jj, errj := cast.ToIntE(j)
+	if erri != nil || errj != nil {
+		return 0, errors.New("At can't be used with non-integer indices")
+	}
+
+	return m.m.At(ii, jj), nil
+}
+
+// Row returns the i'th row of m.
+func (m Matrix) Row(i interface{}) ([]float64, error) {
+	ii, err := cast.ToIntE(i)
+	if err != nil {
+		return nil, errors.New("Row can't be used with a non-integer index")
+	}
+
+	rows, _ := m.m.Dims()
+	if ii < 0 || ii >= rows {
+		return nil, fmt.Errorf("Row index %d out of range [0, %d)", ii, rows)
+	}
+
+	return mat.Row(nil, ii, m.m), nil
+}
+
+// Col returns the j'th column of m.
+func (m Matrix) Col(j interface{}) ([]float64, error) {
+	jj, err := cast.ToIntE(j)
+	if err != nil {
+		return nil, errors.New("Col can't be used with a non-integer index")
+	}
+
+	_, cols := m.m.Dims()
+	if jj < 0 || jj >= cols {
+		return nil, fmt.Errorf("Col index %d out of range [0, %d)", jj, cols)
+	}
+
+	return mat.Col(nil, jj, m.m), nil
+}
+
+// Mul returns the matrix product of m and other.
+func (m Matrix) Mul(other Matrix) (Matrix, error) {
+	mr, mc := m.m.Dims()
+	or, oc := other.m.Dims()
+	if mc != or {
+		return Matrix{}, fmt.Errorf("Mul can't multiply a %dx%d matrix by a %dx%d matrix", mr, mc, or, oc)
+	}
+
+	var res mat.Dense
+	res.Mul(m.m, other.m)
+
+	return Matrix{m: &res}, nil
+}
+
+// Add returns the elementwise sum of m and other.
+func (m Matrix) Add(other Matrix) (Matrix, error) {
+	if !sameDims(m, other) {
+		return Matrix{}, errors.New("Add requires both matrices to have the same dimensions")
+	}
+
+	var res mat.Dense
+	res.Add(m.m, other.m)
+
+	return Matrix{m: &res}, nil
+}
+
+// Sub returns the elementwise difference of m and other.
+func (m Matrix) Sub(other Matrix) (Matrix, error) {
+	if !sameDims(m, other) {
+		return Matrix{}, errors.New("Sub requires both matrices to have the same dimensions")
+	}
+
+	var res mat.Dense
+	res.Sub(m.m, other.m)
+
+	return Matrix{m: &res}, nil
+}
+
+// Scale returns m with every element multiplied by v.
+func (m Matrix) Scale(v interface{}) (Matrix, error) {
+	vf, err := cast.ToFloat64E(v)
+	if err != nil {
+		return Matrix{}, errors.New("Scale can't be used with a non-float value")
+	}
+
+	var res mat.Dense
+	res.Scale(vf, m.m)
+
+	return Matrix{m: &res}, nil
+}
+
+// Transpose returns the transpose of m.
+func (m Matrix) Transpose() (Matrix, error) {
+	var res mat.Dense
+	res.CloneFrom(m.m.T())
+
+	return Matrix{m: &res}, nil
+}
+
+// Determinant returns the determinant of m, which must be square.
+func (m Matrix) Determinant() (float64, error) {
+	rows, cols := m.m.Dims()
+	if rows != cols {
+		return 0, fmt.Errorf("Determinant requires a square matrix, got %dx%d", rows, cols)
+	}
+
+	return mat.Det(m.m), nil
+}
+
+// Inverse returns the inverse of m, which must be square and non-singular.
+func (m Matrix) Inverse() (Matrix, error) {
+	rows, cols := m.m.Dims()
+	if rows != cols {
+		return Matrix{}, fmt.Errorf("Inverse requires a square matrix, got %dx%d", rows, cols)
+	}
+
+	var res mat.Dense
+	if err := res.Inverse(m.m); err != nil {
+		return Matrix{}, fmt.Errorf("Inverse: %w", err)
+	}
+
+	return Matrix{m: &res}, nil
+}
+
+// Solve returns x such that m*x = other, using a least-squares solution
+// when m is not square.
+func (m Matrix) Solve(other Matrix) (Matrix, error) {
+	mr, _ := m.m.Dims()
+	or, _ := other.m.Dims()
+	if mr != or {
+		return Matrix{}, fmt.Errorf("Solve requires matrices with the same number of rows, got %d and %d", mr, or)
+	}
+
+	var res mat.Dense
+	if err := res.Solve(m.m, other.m); err != nil {
+		return Matrix{}, fmt.Errorf("Solve: %w", err)
+	}
+
+	return Matrix{m: &res}, nil
+}
+
+func sameDims(a, b Matrix) bool {
+	ar, ac := a.m.Dims()
+	br, bc := b.m.Dims()
+	return ar == br && ac == bc
+}
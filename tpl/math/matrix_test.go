@@ -0,0 +1,237 @@
+// Copyright 2017 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustMatrix(t *testing.T, rows []interface{}) Matrix {
+	t.Helper()
+	ns := New()
+	m, err := ns.NewMatrix(rows)
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewMatrix(t *testing.T) {
+	ns := New()
+
+	m, err := ns.NewMatrix([]interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+	})
+	require.NoError(t, err)
+	rows, cols := m.Dims()
+	require.Equal(t, 2, rows)
+	require.Equal(t, 2, cols)
+
+	v, err := m.At(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3.0, v)
+}
+
+func TestNewMatrixEmpty(t *testing.T) {
+	ns := New()
+
+	_, err := ns.NewMatrix(nil)
+	require.Error(t, err)
+}
+
+func TestNewMatrixRagged(t *testing.T) {
+	ns := New()
+
+	_, err := ns.NewMatrix([]interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3},
+	})
+	require.Error(t, err)
+}
+
+func TestNewMatrixFlatSlice(t *testing.T) {
+	ns := New()
+
+	_, err := ns.NewMatrix([]interface{}{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestNewMatrixNonNumeric(t *testing.T) {
+	ns := New()
+
+	_, err := ns.NewMatrix([]interface{}{
+		[]interface{}{1, "nope"},
+		[]interface{}{3, 4},
+	})
+	require.Error(t, err)
+}
+
+func TestMatrixMul(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+	})
+	b := mustMatrix(t, []interface{}{
+		[]interface{}{5, 6},
+		[]interface{}{7, 8},
+	})
+
+	res, err := a.Mul(b)
+	require.NoError(t, err)
+
+	v, _ := res.At(0, 0)
+	require.Equal(t, 19.0, v)
+	v, _ = res.At(1, 1)
+	require.Equal(t, 50.0, v)
+}
+
+func TestMatrixMulDimensionMismatch(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2, 3},
+	})
+	b := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2},
+	})
+
+	_, err := a.Mul(b)
+	require.Error(t, err)
+}
+
+func TestMatrixAddSub(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+	})
+	b := mustMatrix(t, []interface{}{
+		[]interface{}{4, 3},
+		[]interface{}{2, 1},
+	})
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	v, _ := sum.At(0, 0)
+	require.Equal(t, 5.0, v)
+
+	diff, err := a.Sub(b)
+	require.NoError(t, err)
+	v, _ = diff.At(0, 0)
+	require.Equal(t, -3.0, v)
+
+	c := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2, 3},
+	})
+	_, err = a.Add(c)
+	require.Error(t, err)
+}
+
+func TestMatrixScale(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+	})
+
+	res, err := a.Scale(2)
+	require.NoError(t, err)
+	v, _ := res.At(1, 1)
+	require.Equal(t, 8.0, v)
+}
+
+func TestMatrixTranspose(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2, 3},
+		[]interface{}{4, 5, 6},
+	})
+
+	res, err := a.Transpose()
+	require.NoError(t, err)
+	rows, cols := res.Dims()
+	require.Equal(t, 3, rows)
+	require.Equal(t, 2, cols)
+
+	v, _ := res.At(2, 1)
+	require.Equal(t, 6.0, v)
+}
+
+func TestMatrixDeterminant(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+	})
+
+	det, err := a.Determinant()
+	require.NoError(t, err)
+	require.Equal(t, -2.0, det)
+
+	nonSquare := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2, 3},
+	})
+	_, err = nonSquare.Determinant()
+	require.Error(t, err)
+}
+
+func TestMatrixInverse(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{4, 7},
+		[]interface{}{2, 6},
+	})
+
+	inv, err := a.Inverse()
+	require.NoError(t, err)
+
+	v, _ := inv.At(0, 0)
+	require.InDelta(t, 0.6, v, 1e-9)
+}
+
+func TestMatrixInverseSingular(t *testing.T) {
+	singular := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{2, 4},
+	})
+
+	_, err := singular.Inverse()
+	require.Error(t, err)
+}
+
+func TestMatrixSolve(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{2, 0},
+		[]interface{}{0, 2},
+	})
+	b := mustMatrix(t, []interface{}{
+		[]interface{}{4},
+		[]interface{}{6},
+	})
+
+	x, err := a.Solve(b)
+	require.NoError(t, err)
+
+	v, _ := x.At(0, 0)
+	require.InDelta(t, 2.0, v, 1e-9)
+	v, _ = x.At(1, 0)
+	require.InDelta(t, 3.0, v, 1e-9)
+}
+
+func TestMatrixSolveRowMismatch(t *testing.T) {
+	a := mustMatrix(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+	})
+	b := mustMatrix(t, []interface{}{
+		[]interface{}{1},
+	})
+
+	_, err := a.Solve(b)
+	require.Error(t, err)
+}
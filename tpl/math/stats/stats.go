@@ -0,0 +1,252 @@
+// Copyright 2017 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spf13/cast"
+	"gonum.org/v1/gonum/stat"
+)
+
+// toFloats coerces xs, a slice of numeric-coercible values, to []float64,
+// returning an aggregated error describing every element that failed to
+// coerce rather than stopping at the first one.
+func toFloats(xs []interface{}) ([]float64, error) {
+	if len(xs) == 0 {
+		return nil, errors.New("can't be used with an empty slice")
+	}
+
+	out := make([]float64, len(xs))
+	var errs []string
+	for i, x := range xs {
+		f, err := cast.ToFloat64E(x)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("index %d: %s", i, err))
+			continue
+		}
+		out[i] = f
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("can't be used with non-numeric values: %s", errs)
+	}
+
+	return out, nil
+}
+
+// Mean returns the arithmetic mean of xs.
+func (ns *Namespace) Mean(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("Mean %s", err)
+	}
+
+	return stat.Mean(fs, nil), nil
+}
+
+// Median returns the median of xs.
+func (ns *Namespace) Median(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("Median %s", err)
+	}
+
+	sorted := append([]float64(nil), fs...)
+	sort.Float64s(sorted)
+
+	return interpolatedQuantile(sorted, 0.5), nil
+}
+
+// Mode returns the most frequent value in xs.
+func (ns *Namespace) Mode(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("Mode %s", err)
+	}
+
+	sorted := append([]float64(nil), fs...)
+	sort.Float64s(sorted)
+
+	mode, _ := stat.Mode(sorted, nil)
+	return mode, nil
+}
+
+// Variance returns the sample variance of xs.
+func (ns *Namespace) Variance(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("Variance %s", err)
+	}
+	if len(fs) < 2 {
+		return 0, errors.New("Variance requires at least two values")
+	}
+
+	return stat.Variance(fs, nil), nil
+}
+
+// PopVariance returns the population variance of xs.
+func (ns *Namespace) PopVariance(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("PopVariance %s", err)
+	}
+
+	_, variance := stat.PopMeanVariance(fs, nil)
+	return variance, nil
+}
+
+// StdDev returns the sample standard deviation of xs.
+func (ns *Namespace) StdDev(xs []interface{}) (float64, error) {
+	variance, err := ns.Variance(xs)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Sqrt(variance), nil
+}
+
+// PopStdDev returns the population standard deviation of xs.
+func (ns *Namespace) PopStdDev(xs []interface{}) (float64, error) {
+	variance, err := ns.PopVariance(xs)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Sqrt(variance), nil
+}
+
+// Min returns the smallest value in xs.
+func (ns *Namespace) Min(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("Min %s", err)
+	}
+
+	min := fs[0]
+	for _, f := range fs[1:] {
+		if f < min {
+			min = f
+		}
+	}
+
+	return min, nil
+}
+
+// Max returns the largest value in xs.
+func (ns *Namespace) Max(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("Max %s", err)
+	}
+
+	max := fs[0]
+	for _, f := range fs[1:] {
+		if f > max {
+			max = f
+		}
+	}
+
+	return max, nil
+}
+
+// Sum returns the sum of xs.
+func (ns *Namespace) Sum(xs []interface{}) (float64, error) {
+	fs, err := toFloats(xs)
+	if err != nil {
+		return 0, fmt.Errorf("Sum %s", err)
+	}
+
+	var sum float64
+	for _, f := range fs {
+		sum += f
+	}
+
+	return sum, nil
+}
+
+// Quantile returns the p'th quantile (p in [0, 1]) of xs, using linear
+// interpolation between closest ranks.
+func (ns *Namespace) Quantile(p, xs interface{}) (float64, error) {
+	pf, err := cast.ToFloat64E(p)
+	if err != nil {
+		return 0, errors.New("Quantile requires a numeric p")
+	}
+	if pf < 0 || pf > 1 {
+		return 0, errors.New("Quantile requires p in [0, 1]")
+	}
+
+	xsSlice, err := cast.ToSliceE(xs)
+	if err != nil {
+		return 0, errors.New("Quantile requires a slice of numeric values")
+	}
+
+	fs, err := toFloats(xsSlice)
+	if err != nil {
+		return 0, fmt.Errorf("Quantile %s", err)
+	}
+
+	sorted := append([]float64(nil), fs...)
+	sort.Float64s(sorted)
+
+	return interpolatedQuantile(sorted, pf), nil
+}
+
+// interpolatedQuantile returns the p'th quantile (p in [0, 1]) of sorted,
+// which must already be sorted ascending, linearly interpolating between
+// the two closest ranks when p doesn't land exactly on one.
+func interpolatedQuantile(sorted []float64, p float64) float64 {
+	n := len(sorted)
+	if n == 1 {
+		return sorted[0]
+	}
+
+	pos := p * float64(n-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	if lo == hi {
+		return sorted[lo]
+	}
+
+	frac := pos - float64(lo)
+	return sorted[lo] + (sorted[hi]-sorted[lo])*frac
+}
+
+// Correlation returns the Pearson correlation coefficient between xs and ys.
+func (ns *Namespace) Correlation(xs, ys interface{}) (float64, error) {
+	xsSlice, errx := cast.ToSliceE(xs)
+	ysSlice, erry := cast.ToSliceE(ys)
+	if errx != nil || erry != nil {
+		return 0, errors.New("Correlation requires two slices of numeric values")
+	}
+
+	if len(xsSlice) != len(ysSlice) {
+		return 0, fmt.Errorf("Correlation requires equal-length slices, got %d and %d", len(xsSlice), len(ysSlice))
+	}
+
+	xf, err := toFloats(xsSlice)
+	if err != nil {
+		return 0, fmt.Errorf("Correlation %s", err)
+	}
+
+	yf, err := toFloats(ysSlice)
+	if err != nil {
+		return 0, fmt.Errorf("Correlation %s", err)
+	}
+
+	return stat.Correlation(xf, yf, nil), nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ifaces(xs ...interface{}) []interface{} {
+	return xs
+}
+
+func TestMean(t *testing.T) {
+	ns := New()
+
+	got, err := ns.Mean(ifaces(1, 2, 3, 4))
+	require.NoError(t, err)
+	require.Equal(t, 2.5, got)
+
+	_, err = ns.Mean(ifaces())
+	require.Error(t, err)
+
+	_, err = ns.Mean(ifaces(1, "nope"))
+	require.Error(t, err)
+}
+
+func TestMedian(t *testing.T) {
+	ns := New()
+
+	got, err := ns.Median(ifaces(1, 2, 3, 4))
+	require.NoError(t, err)
+	require.Equal(t, 2.5, got)
+
+	got, err = ns.Median(ifaces(1, 2, 3))
+	require.NoError(t, err)
+	require.Equal(t, 2.0, got)
+}
+
+func TestMode(t *testing.T) {
+	ns := New()
+
+	got, err := ns.Mode(ifaces(1, 2, 2, 3))
+	require.NoError(t, err)
+	require.Equal(t, 2.0, got)
+}
+
+func TestVariance(t *testing.T) {
+	ns := New()
+
+	got, err := ns.Variance(ifaces(2, 4, 4, 4, 5, 5, 7, 9))
+	require.NoError(t, err)
+	require.InDelta(t, 4.571428, got, 1e-5)
+
+	_, err = ns.Variance(ifaces(1))
+	require.Error(t, err)
+}
+
+func TestPopVariance(t *testing.T) {
+	ns := New()
+
+	got, err := ns.PopVariance(ifaces(2, 4, 4, 4, 5, 5, 7, 9))
+	require.NoError(t, err)
+	require.InDelta(t, 4.0, got, 1e-9)
+}
+
+func TestStdDev(t *testing.T) {
+	ns := New()
+
+	got, err := ns.StdDev(ifaces(2, 4, 4, 4, 5, 5, 7, 9))
+	require.NoError(t, err)
+	require.InDelta(t, 2.13809, got, 1e-4)
+}
+
+func TestPopStdDev(t *testing.T) {
+	ns := New()
+
+	got, err := ns.PopStdDev(ifaces(2, 4, 4, 4, 5, 5, 7, 9))
+	require.NoError(t, err)
+	require.InDelta(t, 2.0, got, 1e-9)
+}
+
+func TestMinMaxSum(t *testing.T) {
+	ns := New()
+
+	min, err := ns.Min(ifaces(3, 1, 2))
+	require.NoError(t, err)
+	require.Equal(t, 1.0, min)
+
+	max, err := ns.Max(ifaces(3, 1, 2))
+	require.NoError(t, err)
+	require.Equal(t, 3.0, max)
+
+	sum, err := ns.Sum(ifaces(3, 1, 2))
+	require.NoError(t, err)
+	require.Equal(t, 6.0, sum)
+}
+
+func TestQuantile(t *testing.T) {
+	ns := New()
+
+	got, err := ns.Quantile(0.5, ifaces(1, 2, 3, 4))
+	require.NoError(t, err)
+	require.Equal(t, 2.5, got)
+
+	_, err = ns.Quantile(1.5, ifaces(1, 2, 3))
+	require.Error(t, err)
+}
+
+func TestCorrelation(t *testing.T) {
+	ns := New()
+
+	got, err := ns.Correlation(ifaces(1, 2, 3), ifaces(2, 4, 6))
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, got, 1e-9)
+
+	_, err = ns.Correlation(ifaces(1, 2, 3), ifaces(1, 2))
+	require.Error(t, err)
+}
@@ -0,0 +1,257 @@
+// Copyright 2017 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math
+
+import (
+	"errors"
+	"math"
+
+	"github.com/spf13/cast"
+)
+
+// Sin returns the sine of the radian argument x.
+func (ns *Namespace) Sin(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Sin operator can't be used with non-float value")
+	}
+
+	return math.Sin(xf), nil
+}
+
+// Cos returns the cosine of the radian argument x.
+func (ns *Namespace) Cos(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Cos operator can't be used with non-float value")
+	}
+
+	return math.Cos(xf), nil
+}
+
+// Tan returns the tangent of the radian argument x.
+func (ns *Namespace) Tan(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Tan operator can't be used with non-float value")
+	}
+
+	return math.Tan(xf), nil
+}
+
+// Asin returns the arcsine, in radians, of x.
+func (ns *Namespace) Asin(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Asin operator can't be used with non-float value")
+	}
+
+	return math.Asin(xf), nil
+}
+
+// Acos returns the arccosine, in radians, of x.
+func (ns *Namespace) Acos(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Acos operator can't be used with non-float value")
+	}
+
+	return math.Acos(xf), nil
+}
+
+// Atan returns the arctangent, in radians, of x.
+func (ns *Namespace) Atan(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Atan operator can't be used with non-float value")
+	}
+
+	return math.Atan(xf), nil
+}
+
+// Atan2 returns the arc tangent of y/x, using the signs of the two to
+// determine the quadrant of the result.
+func (ns *Namespace) Atan2(y, x interface{}) (float64, error) {
+	yf, erry := cast.ToFloat64E(y)
+	xf, errx := cast.ToFloat64E(x)
+	if erry != nil || errx != nil {
+		return 0, errors.New("Atan2 operator can't be used with non-float value")
+	}
+
+	return math.Atan2(yf, xf), nil
+}
+
+// Sinh returns the hyperbolic sine of x.
+func (ns *Namespace) Sinh(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Sinh operator can't be used with non-float value")
+	}
+
+	return math.Sinh(xf), nil
+}
+
+// Cosh returns the hyperbolic cosine of x.
+func (ns *Namespace) Cosh(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Cosh operator can't be used with non-float value")
+	}
+
+	return math.Cosh(xf), nil
+}
+
+// Tanh returns the hyperbolic tangent of x.
+func (ns *Namespace) Tanh(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Tanh operator can't be used with non-float value")
+	}
+
+	return math.Tanh(xf), nil
+}
+
+// Exp returns e**x, the base-e exponential of x.
+func (ns *Namespace) Exp(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Exp operator can't be used with non-float value")
+	}
+
+	return math.Exp(xf), nil
+}
+
+// Log2 returns the binary logarithm of x.
+func (ns *Namespace) Log2(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Log2 operator can't be used with non-float value")
+	}
+
+	return math.Log2(xf), nil
+}
+
+// Log10 returns the decimal logarithm of x.
+func (ns *Namespace) Log10(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Log10 operator can't be used with non-float value")
+	}
+
+	return math.Log10(xf), nil
+}
+
+// Hypot returns Sqrt(a*a + b*b), taking care to avoid overflow and underflow.
+func (ns *Namespace) Hypot(a, b interface{}) (float64, error) {
+	af, erra := cast.ToFloat64E(a)
+	bf, errb := cast.ToFloat64E(b)
+	if erra != nil || errb != nil {
+		return 0, errors.New("Hypot operator can't be used with non-float value")
+	}
+
+	return math.Hypot(af, bf), nil
+}
+
+// Abs returns the absolute value of x.
+func (ns *Namespace) Abs(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Abs operator can't be used with non-float value")
+	}
+
+	return math.Abs(xf), nil
+}
+
+// Sign returns the sign of x: -1 if x < 0, 0 if x == 0, and 1 if x > 0.
+func (ns *Namespace) Sign(x interface{}) (float64, error) {
+	xf, err := cast.ToFloat64E(x)
+	if err != nil {
+		return 0, errors.New("Sign operator can't be used with non-float value")
+	}
+
+	switch {
+	case xf < 0:
+		return -1, nil
+	case xf > 0:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Radians converts deg, an angle in degrees, to radians.
+func (ns *Namespace) Radians(deg interface{}) (float64, error) {
+	df, err := cast.ToFloat64E(deg)
+	if err != nil {
+		return 0, errors.New("Radians operator can't be used with non-float value")
+	}
+
+	return df * math.Pi / 180, nil
+}
+
+// Degrees converts rad, an angle in radians, to degrees.
+func (ns *Namespace) Degrees(rad interface{}) (float64, error) {
+	rf, err := cast.ToFloat64E(rad)
+	if err != nil {
+		return 0, errors.New("Degrees operator can't be used with non-float value")
+	}
+
+	return rf * 180 / math.Pi, nil
+}
+
+// Max returns the largest of the given numbers.
+func (ns *Namespace) Max(a interface{}, b ...interface{}) (float64, error) {
+	all := append([]interface{}{a}, b...)
+
+	af, err := cast.ToFloat64E(all[0])
+	if err != nil {
+		return 0, errors.New("Max operator can't be used with non-float value")
+	}
+
+	max := af
+	for _, v := range all[1:] {
+		vf, err := cast.ToFloat64E(v)
+		if err != nil {
+			return 0, errors.New("Max operator can't be used with non-float value")
+		}
+		if vf > max {
+			max = vf
+		}
+	}
+
+	return max, nil
+}
+
+// Min returns the smallest of the given numbers.
+func (ns *Namespace) Min(a interface{}, b ...interface{}) (float64, error) {
+	all := append([]interface{}{a}, b...)
+
+	af, err := cast.ToFloat64E(all[0])
+	if err != nil {
+		return 0, errors.New("Min operator can't be used with non-float value")
+	}
+
+	min := af
+	for _, v := range all[1:] {
+		vf, err := cast.ToFloat64E(v)
+		if err != nil {
+			return 0, errors.New("Min operator can't be used with non-float value")
+		}
+		if vf < min {
+			min = vf
+		}
+	}
+
+	return min, nil
+}